@@ -47,6 +47,7 @@ type Option[T any] func(o *T)
 
 type unmarshalOptions struct {
 	skipValidation bool
+	bestEffort     bool
 }
 
 // WithSkipValidation will skip validation of the Arazzo document during unmarshalling.
@@ -57,6 +58,16 @@ func WithSkipValidation() Option[unmarshalOptions] {
 	}
 }
 
+// WithBestEffort will continue unmarshalling the Arazzo document past fields that fail to unmarshal (for example a value of the wrong type),
+// leaving those fields unset and reporting the failures as validation errors, so the rest of the document can still be validated.
+// The failures are reported even when validation is skipped via arazzo.WithSkipValidation().
+// Documents that are not syntactically valid YAML or JSON will still return an error.
+func WithBestEffort() Option[unmarshalOptions] {
+	return func(o *unmarshalOptions) {
+		o.bestEffort = true
+	}
+}
+
 // Unmarshal will unmarshal and validate an Arazzo document from the provided io.Reader.
 // Validation can be skipped by using arazzo.WithSkipValidation() as one of the options when calling this function.
 func Unmarshal(ctx context.Context, doc io.Reader, opts ...Option[unmarshalOptions]) (*Arazzo, []error, error) {
@@ -66,6 +77,9 @@ func Unmarshal(ctx context.Context, doc io.Reader, opts ...Option[unmarshalOptio
 	}
 
 	ctx = validation.ContextWithValidationContext(ctx)
+	if o.bestEffort {
+		ctx = marshaller.ContextWithBestEffort(ctx)
+	}
 
 	c, err := core.Unmarshal(ctx, doc)
 	if err != nil {
@@ -78,28 +92,31 @@ func Unmarshal(ctx context.Context, doc io.Reader, opts ...Option[unmarshalOptio
 	}
 
 	var validationErrs []error
+	if o.bestEffort {
+		validationErrs = append(validationErrs, marshaller.GetBestEffortErrors(ctx)...)
+	}
 	if !o.skipValidation {
-		validationErrs = validation.GetValidationErrors(ctx)
+		validationErrs = append(validationErrs, validation.GetValidationErrors(ctx)...)
 		validationErrs = append(validationErrs, arazzo.Validate(ctx)...)
-		slices.SortFunc(validationErrs, func(a, b error) int {
-			var aValidationErr *validation.Error
-			var bValidationErr *validation.Error
-			aIsValidationErr := errors.As(a, &aValidationErr)
-			bIsValidationErr := errors.As(b, &bValidationErr)
-			if aIsValidationErr && bIsValidationErr {
-				if aValidationErr.Line == bValidationErr.Line {
-					return aValidationErr.Column - bValidationErr.Column
-				}
-				return aValidationErr.Line - bValidationErr.Line
-			} else if aIsValidationErr {
-				return -1
-			} else if bIsValidationErr {
-				return 1
+	}
+	slices.SortFunc(validationErrs, func(a, b error) int {
+		var aValidationErr *validation.Error
+		var bValidationErr *validation.Error
+		aIsValidationErr := errors.As(a, &aValidationErr)
+		bIsValidationErr := errors.As(b, &bValidationErr)
+		if aIsValidationErr && bIsValidationErr {
+			if aValidationErr.Line == bValidationErr.Line {
+				return aValidationErr.Column - bValidationErr.Column
 			}
+			return aValidationErr.Line - bValidationErr.Line
+		} else if aIsValidationErr {
+			return -1
+		} else if bIsValidationErr {
+			return 1
+		}
 
-			return 0
-		})
-	}
+		return 0
+	})
 
 	return arazzo, validationErrs, nil
 }
@@ -145,6 +162,7 @@ func (a *Arazzo) Marshal(ctx context.Context, w io.Writer) error {
 // Validate will validate the Arazzo document against the Arazzo Specification.
 func (a *Arazzo) Validate(ctx context.Context, opts ...validation.Option) []error {
 	opts = append(opts, validation.WithContextObject(a))
+	o := validation.NewOptions(opts...)
 
 	errs := []error{}
 
@@ -161,6 +179,10 @@ func (a *Arazzo) Validate(ctx context.Context, opts ...validation.Option) []erro
 	sourceDescriptionNames := make(map[string]bool)
 
 	for i, sourceDescription := range a.SourceDescriptions {
+		if o.MaxErrorsExceeded(errs) {
+			break
+		}
+
 		errs = append(errs, sourceDescription.Validate(ctx, opts...)...)
 
 		if _, ok := sourceDescriptionNames[sourceDescription.Name]; ok {
@@ -177,6 +199,10 @@ func (a *Arazzo) Validate(ctx context.Context, opts ...validation.Option) []erro
 	workflowIds := make(map[string]bool)
 
 	for i, workflow := range a.Workflows {
+		if o.MaxErrorsExceeded(errs) {
+			break
+		}
+
 		errs = append(errs, workflow.Validate(ctx, opts...)...)
 
 		if _, ok := workflowIds[workflow.WorkflowID]; ok {
@@ -190,7 +216,7 @@ func (a *Arazzo) Validate(ctx context.Context, opts ...validation.Option) []erro
 		workflowIds[workflow.WorkflowID] = true
 	}
 
-	if a.Components != nil {
+	if a.Components != nil && !o.MaxErrorsExceeded(errs) {
 		errs = append(errs, a.Components.Validate(ctx, opts...)...)
 	}
 
@@ -198,5 +224,5 @@ func (a *Arazzo) Validate(ctx context.Context, opts ...validation.Option) []erro
 		a.Valid = true
 	}
 
-	return errs
+	return o.TruncateErrors(errs)
 }
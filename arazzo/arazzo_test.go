@@ -236,6 +236,26 @@ func TestArazzo_RoundTrip_Success(t *testing.T) {
 	assert.Equal(t, doc, outBuf.String())
 }
 
+func TestArazzo_RoundTrip_BOMAndCRLF_Success(t *testing.T) {
+	ctx := context.Background()
+
+	data, err := os.ReadFile("testdata/test.arazzo.yaml")
+	require.NoError(t, err)
+
+	doc := "\xEF\xBB\xBF" + strings.ReplaceAll(fmt.Sprintf(string(data), ""), "\n", "\r\n")
+
+	a, validationErrs, err := arazzo.Unmarshal(ctx, bytes.NewBuffer([]byte(doc)))
+	require.NoError(t, err)
+	require.Empty(t, validationErrs)
+
+	outBuf := bytes.NewBuffer([]byte{})
+
+	err = arazzo.Marshal(ctx, a, outBuf)
+	require.NoError(t, err)
+
+	assert.Equal(t, doc, outBuf.String())
+}
+
 func TestArazzoUnmarshal_ValidationErrors(t *testing.T) {
 	data := []byte(`arazzo: 1.0.1
 x-test: some-value
@@ -297,6 +317,160 @@ sourceDescriptions:
 	}
 }
 
+func TestArazzoUnmarshal_BestEffort_ValidationErrors(t *testing.T) {
+	data := []byte(`arazzo: 1.0.0
+info:
+  title: My Workflow
+  version: 1.0.0
+sourceDescriptions:
+  - name: api
+    url: https://example.com/openapi.yaml
+    type: openapi
+workflows:
+  - workflowId: workflow1
+    steps:
+      - stepId: step1
+        operationId: getUser
+        parameters: not-a-list
+      - stepId: step2
+        operationId: getUser
+  - workflowId: workflow1
+    steps:
+      - stepId: step1
+        operationId: getPet
+`)
+
+	ctx := context.Background()
+
+	_, _, err := arazzo.Unmarshal(ctx, bytes.NewBuffer(data))
+	require.Error(t, err)
+
+	a, validationErrs, err := arazzo.Unmarshal(ctx, bytes.NewBuffer(data), arazzo.WithBestEffort())
+	require.NoError(t, err)
+
+	assert.Equal(t, []error{
+		&validation.Error{Line: 14, Column: 21, Message: "failed to unmarshal field parameters: expected sequence node, got scalar node"},
+		&validation.Error{Line: 17, Column: 5, Message: "workflowId workflow1 is not unique"},
+	}, validationErrs)
+
+	require.Len(t, a.Workflows, 2)
+	require.Len(t, a.Workflows[0].Steps, 2)
+	assert.Nil(t, a.Workflows[0].Steps[0].Parameters)
+	assert.Equal(t, "step2", a.Workflows[0].Steps[1].StepID)
+}
+
+func TestArazzoUnmarshal_BestEffort_MalformedItems(t *testing.T) {
+	data := []byte(`arazzo: 1.0.0
+info:
+  title: My Workflow
+  version: 1.0.0
+sourceDescriptions:
+  - name: api
+    url: https://example.com/openapi.yaml
+    type: openapi
+workflows:
+  - not-a-workflow
+  - workflowId: workflow1
+    steps:
+      - stepId: step1
+        operationId: getUser
+  - workflowId: workflow1
+    steps:
+      - stepId: step1
+        operationId: getPet
+`)
+
+	ctx := context.Background()
+
+	a, validationErrs, err := arazzo.Unmarshal(ctx, bytes.NewBuffer(data), arazzo.WithBestEffort())
+	require.NoError(t, err)
+
+	assert.Equal(t, []error{
+		&validation.Error{Line: 10, Column: 5, Message: "failed to unmarshal item 0: expected mapping node, got scalar node"},
+		&validation.Error{Line: 15, Column: 5, Message: "workflowId workflow1 is not unique"},
+	}, validationErrs)
+
+	require.Len(t, a.Workflows, 2)
+	assert.Equal(t, "getUser", string(*a.Workflows[0].Steps[0].OperationID))
+	assert.Equal(t, "getPet", string(*a.Workflows[1].Steps[0].OperationID))
+
+	buf := bytes.NewBuffer([]byte{})
+	require.NoError(t, arazzo.Marshal(ctx, a, buf))
+	assert.NotContains(t, buf.String(), "not-a-workflow")
+	assert.Contains(t, buf.String(), "operationId: getPet")
+}
+
+func TestArazzoUnmarshal_BestEffortWithSkipValidation_UnmarshalErrors(t *testing.T) {
+	data := []byte(`arazzo: 1.0.0
+info:
+  title: My Workflow
+  version: [1, 0, 0]
+sourceDescriptions:
+  - name: api
+    url: https://example.com/openapi.yaml
+    type: openapi
+workflows:
+  - workflowId: workflow1
+    steps:
+      - stepId: step1
+        operationId: getUser
+        parameters: not-a-list
+  - workflowId: workflow1
+    steps:
+      - stepId: step1
+        operationId: getPet
+`)
+
+	a, validationErrs, err := arazzo.Unmarshal(context.Background(), bytes.NewBuffer(data), arazzo.WithBestEffort(), arazzo.WithSkipValidation())
+	require.NoError(t, err)
+
+	assert.Equal(t, []error{
+		&validation.Error{Line: 4, Column: 12, Message: "failed to unmarshal field version: expected scalar node, got sequence node"},
+		&validation.Error{Line: 14, Column: 21, Message: "failed to unmarshal field parameters: expected sequence node, got scalar node"},
+	}, validationErrs)
+
+	assert.Empty(t, a.Info.Version)
+	require.Len(t, a.Workflows, 2)
+	assert.Nil(t, a.Workflows[0].Steps[0].Parameters)
+}
+
+func TestArazzo_Validate_MaxErrors(t *testing.T) {
+	data := []byte(`arazzo: 1.0.1
+info:
+  title: My Workflow
+  version: 1.0.0
+sourceDescriptions:
+  - name: api1
+    url: https://example.com/openapi.yaml
+    type: openapis
+  - name: api2
+    url: https://example.com/openapi.yaml
+    type: openapis
+  - name: api3
+    url: https://example.com/openapi.yaml
+    type: openapis
+workflows: []
+`)
+
+	ctx := context.Background()
+
+	a, _, err := arazzo.Unmarshal(ctx, bytes.NewBuffer(data), arazzo.WithSkipValidation())
+	require.NoError(t, err)
+
+	errs := a.Validate(ctx, validation.WithMaxErrors(2))
+	assert.Equal(t, []error{
+		&validation.Error{Line: 1, Column: 9, Message: "Arazzo version must be 1.0.0"},
+		&validation.Error{Line: 8, Column: 11, Message: "type must be one of [openapi, arazzo]"},
+		&validation.TruncatedError{MaxErrors: 2},
+	}, errs)
+	assert.True(t, validation.IsTruncated(errs))
+	assert.False(t, a.Valid)
+
+	errs = a.Validate(ctx, validation.WithMaxErrors(4))
+	assert.Len(t, errs, 4)
+	assert.False(t, validation.IsTruncated(errs))
+}
+
 func TestArazzo_Mutate_Success(t *testing.T) {
 	ctx := context.Background()
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -61,19 +62,21 @@ func (a *Arazzo) Unmarshal(ctx context.Context, node *yaml.Node) error {
 func (a *Arazzo) Marshal(ctx context.Context, w io.Writer) error {
 	cfg := yml.GetConfigFromContext(ctx)
 
+	buf := bytes.NewBuffer([]byte{})
+
 	switch cfg.OutputFormat {
 	case yml.OutputFormatYAML:
-		enc := yaml.NewEncoder(w)
+		enc := yaml.NewEncoder(buf)
 
 		enc.SetIndent(cfg.Indentation)
 		if err := enc.Encode(a.RootNode); err != nil {
 			return err
 		}
 	case yml.OutputFormatJSON:
-		if err := json.YAMLToJSON(a.RootNode, cfg.Indentation, w); err != nil {
+		if err := json.YAMLToJSON(a.RootNode, cfg.Indentation, buf); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return cfg.Write(w, buf.Bytes())
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/speakeasy-api/openapi/errors"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -62,6 +63,7 @@ func (j JSONPointer) Validate() error {
 // GetTarget will evaluate the JSONPointer against the source and return the target.
 // WithStructTags can be used to set the type of struct tags to use when navigating structs.
 // If the struct implements any of the Navigable interfaces it will be used to navigate the source.
+// If the source is a yaml.Node it will be navigated based on the kind of each node encountered, so numeric reference tokens are treated as keys for mapping nodes and indices for sequence nodes.
 func GetTarget(source any, pointer JSONPointer, opts ...option) (any, error) {
 	o := getOptions(opts...)
 
@@ -79,6 +81,7 @@ func GetTarget(source any, pointer JSONPointer, opts ...option) (any, error) {
 }
 
 // PartsToJSONPointer will convert the exploded parts of a JSONPointer to a JSONPointer.
+// Each part is escaped as per RFC6901, with ~ encoded as ~0 and / encoded as ~1.
 func PartsToJSONPointer(parts []string) JSONPointer {
 	var sb strings.Builder
 	for _, part := range parts {
@@ -102,6 +105,16 @@ func getCurrentStackTarget(source any, stack []navigationPart, currentPath strin
 }
 
 func getTarget(source any, currentPart navigationPart, stack []navigationPart, currentPath string, o *options) (any, []navigationPart, error) {
+	switch node := source.(type) {
+	case *yaml.Node:
+		if node == nil {
+			return nil, nil, ErrNotFound.Wrap(fmt.Errorf("yaml node is nil at %s", currentPath))
+		}
+		return getYamlNodeTarget(node, currentPart, stack, currentPath, o)
+	case yaml.Node:
+		return getYamlNodeTarget(&node, currentPart, stack, currentPath, o)
+	}
+
 	sourceType := reflect.TypeOf(source)
 	sourceElemType := sourceType
 
@@ -124,9 +137,7 @@ func getTarget(source any, currentPart navigationPart, stack []navigationPart, c
 func getMapTarget(sourceVal reflect.Value, currentPart navigationPart, stack []navigationPart, currentPath string, o *options) (any, []navigationPart, error) {
 	sourceValElem := reflect.Indirect(sourceVal)
 
-	if currentPart.Type != partTypeKey {
-		return nil, nil, ErrInvalidPath.Wrap(fmt.Errorf("expected key, got %s at %s", currentPart.Type, currentPath))
-	}
+	// Reference tokens that look like indices (ie response codes) are valid keys for maps, so navigate by the token value regardless of its type
 	if sourceValElem.Type().Key().Kind() != reflect.String {
 		return nil, nil, ErrInvalidPath.Wrap(fmt.Errorf("expected map key to be string, got %s at %s", sourceValElem.Type().Key().Kind(), currentPath))
 	}
@@ -164,6 +175,46 @@ func getSliceTarget(sourceVal reflect.Value, currentPart navigationPart, stack [
 	return getCurrentStackTarget(sourceValElem.Index(index).Interface(), stack, currentPath, o)
 }
 
+func getYamlNodeTarget(node *yaml.Node, currentPart navigationPart, stack []navigationPart, currentPath string, o *options) (any, []navigationPart, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil, ErrNotFound.Wrap(fmt.Errorf("document node is empty at %s", currentPath))
+		}
+		return getYamlNodeTarget(node.Content[0], currentPart, stack, currentPath, o)
+	case yaml.AliasNode:
+		if node.Alias == nil {
+			return nil, nil, ErrNotFound.Wrap(fmt.Errorf("alias node has no target at %s", currentPath))
+		}
+		return getYamlNodeTarget(node.Alias, currentPart, stack, currentPath, o)
+	case yaml.MappingNode:
+		// Any reference token can be a key in a mapping node, including those that look like indices
+		key := currentPart.unescapeValue()
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				return getCurrentStackTarget(node.Content[i+1], stack, currentPath, o)
+			}
+		}
+
+		return nil, nil, ErrNotFound.Wrap(fmt.Errorf("key %s not found in mapping node at %s", key, currentPath))
+	case yaml.SequenceNode:
+		if currentPart.Type != partTypeIndex {
+			return nil, nil, ErrInvalidPath.Wrap(fmt.Errorf("expected index for sequence node, got %s at %s", currentPart.Type, currentPath))
+		}
+
+		index := currentPart.getIndex()
+
+		if index < 0 || index >= len(node.Content) {
+			return nil, nil, ErrNotFound.Wrap(fmt.Errorf("index %d out of range for sequence node of length %d at %s", index, len(node.Content), currentPath))
+		}
+
+		return getCurrentStackTarget(node.Content[index], stack, currentPath, o)
+	default:
+		return nil, nil, ErrInvalidPath.Wrap(fmt.Errorf("expected mapping or sequence node, got scalar node at %s", currentPath))
+	}
+}
+
 // KeyNavigable is an interface that can be implemented by a struct to allow navigation by key, bypassing navigating by struct tags.
 type KeyNavigable interface {
 	NavigateWithKey(key string) (any, error)
@@ -260,6 +311,9 @@ func getIndexBasedStructTarget(sourceVal reflect.Value, currentPart navigationPa
 		} else {
 			return val, stack, nil
 		}
+	} else if sourceVal.Type().Implements(reflect.TypeOf((*KeyNavigable)(nil)).Elem()) {
+		// Map like structs (ie sequenced maps) can have keys that look like indices (ie response codes)
+		return getKeyBasedStructTarget(sourceVal, currentPart, stack, currentPath, o)
 	} else {
 		return nil, nil, ErrNotFound.Wrap(fmt.Errorf("expected IndexNavigable, got %s at %s", sourceVal.Kind(), currentPath))
 	}
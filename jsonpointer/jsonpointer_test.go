@@ -8,6 +8,7 @@ import (
 	"github.com/speakeasy-api/openapi/sequencedmap"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestJSONPointer_Validate_Success(t *testing.T) {
@@ -103,6 +104,95 @@ func TestJSONPointer_Validate_Error(t *testing.T) {
 	}
 }
 
+func TestPartsToJSONPointer_Success(t *testing.T) {
+	type args struct {
+		parts []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want JSONPointer
+	}{
+		{
+			name: "no parts",
+			args: args{
+				parts: []string{},
+			},
+			want: JSONPointer(""),
+		},
+		{
+			name: "simple parts",
+			args: args{
+				parts: []string{"some", "path", "0"},
+			},
+			want: JSONPointer("/some/path/0"),
+		},
+		{
+			name: "part with / characters",
+			args: args{
+				parts: []string{"paths", "/a/b~c", "get"},
+			},
+			want: JSONPointer("/paths/~1a~1b~0c/get"),
+		},
+		{
+			name: "part with ~ followed by 1",
+			args: args{
+				parts: []string{"~1"},
+			},
+			want: JSONPointer("/~01"),
+		},
+		{
+			name: "part with ~ followed by /",
+			args: args{
+				parts: []string{"~/"},
+			},
+			want: JSONPointer("/~0~1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PartsToJSONPointer(tt.args.parts)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPartsToJSONPointer_RoundTrip_Success(t *testing.T) {
+	source := map[string]any{
+		"/a/b~c": map[string]any{
+			"~1": 1,
+			"~/": 2,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		parts []string
+		want  any
+	}{
+		{
+			name:  "key with / and ~ characters",
+			parts: []string{"/a/b~c", "~1"},
+			want:  1,
+		},
+		{
+			name:  "key with escape like sequences",
+			parts: []string{"/a/b~c", "~/"},
+			want:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jp := PartsToJSONPointer(tt.parts)
+			require.NoError(t, jp.Validate())
+
+			target, err := GetTarget(source, jp)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, target)
+		})
+	}
+}
+
 func TestGetTarget_Success(t *testing.T) {
 	type TestSimpleStructNoTags struct {
 		A int
@@ -130,6 +220,12 @@ func TestGetTarget_Success(t *testing.T) {
 		B []TestStructLevel2 `key:"b"`
 		C bool               `key:"c"`
 	}
+	type TestResponse struct {
+		Description string `key:"description"`
+	}
+	type TestComponents struct {
+		Responses *sequencedmap.Map[string, TestResponse] `key:"responses"`
+	}
 	type TestStructTopLevel struct {
 		A map[string]any                              `key:"a"`
 		B TestStructLevel1                            `key:"b"`
@@ -241,6 +337,24 @@ func TestGetTarget_Success(t *testing.T) {
 			},
 			want: 2,
 		},
+		{
+			name: "index like key in map",
+			args: args{
+				source:  map[string]any{"400": 1},
+				pointer: JSONPointer("/400"),
+			},
+			want: 1,
+		},
+		{
+			name: "index like key in sequenced map",
+			args: args{
+				source: TestComponents{
+					Responses: sequencedmap.New(sequencedmap.NewElem("400", TestResponse{Description: "Bad Request"})),
+				},
+				pointer: JSONPointer("/responses/400/description"),
+			},
+			want: "Bad Request",
+		},
 		{
 			name: "works with sequenced maps",
 			args: args{
@@ -304,12 +418,20 @@ func TestGetTarget_Error(t *testing.T) {
 			wantErr: errors.New("invalid path -- expected index, got key at /key1"),
 		},
 		{
-			name: "index in map",
+			name: "index like key not found in map",
 			args: args{
 				source:  map[string]any{"key1": 1},
 				pointer: JSONPointer("/0"),
 			},
-			wantErr: errors.New("invalid path -- expected key, got index at /0"),
+			wantErr: errors.New("not found -- key 0 not found in map at /0"),
+		},
+		{
+			name: "index like key not found in sequenced map",
+			args: args{
+				source:  sequencedmap.New(sequencedmap.NewElem("200", 1)),
+				pointer: JSONPointer("/400"),
+			},
+			wantErr: errors.New("not found -- key 400 not found in sequencedmap.Map"),
 		},
 		{
 			name: "nil map",
@@ -434,6 +556,25 @@ func (t InterfaceTestStruct) NavigateWithIndex(index int) (any, error) {
 	}
 }
 
+type KeyNavigableTestStruct struct {
+	typ         string
+	valuesByKey map[string]any
+	BadRequest  any `key:"400"`
+}
+
+var _ KeyNavigable = (*KeyNavigableTestStruct)(nil)
+
+func (t KeyNavigableTestStruct) NavigateWithKey(key string) (any, error) {
+	switch t.typ {
+	case "map":
+		return t.valuesByKey[key], nil
+	case "struct":
+		return nil, ErrSkipInterface
+	default:
+		return nil, fmt.Errorf("unknown type %s", t.typ)
+	}
+}
+
 type NavigableNodeWrapper struct {
 	typ           string
 	NavigableNode InterfaceTestStruct
@@ -491,6 +632,23 @@ func TestGetTarget_WithInterfaces_Success(t *testing.T) {
 			},
 			want: "value1",
 		},
+		{
+			name: "KeyNavigable succeeds with index like key",
+			args: args{
+				source:  KeyNavigableTestStruct{typ: "map", valuesByKey: map[string]any{"400": "value1"}},
+				pointer: JSONPointer("/400"),
+			},
+			want: "value1",
+		},
+		{
+			name: "KeyNavigable struct is navigable with index like key",
+			args: args{
+				source:  KeyNavigableTestStruct{typ: "struct", BadRequest: "value1"},
+				pointer: JSONPointer("/400"),
+				opts:    []option{WithStructTags("key")},
+			},
+			want: "value1",
+		},
 		{
 			name: "NavigableNoder succeeds",
 			args: args{
@@ -553,3 +711,132 @@ func TestGetTarget_WithInterfaces_Error(t *testing.T) {
 		})
 	}
 }
+
+const testYamlDoc = `openapi: 3.1.0
+servers:
+  - url: https://api.example.com
+  - url: https://staging.example.com
+paths:
+  /x:
+    get:
+      tags: [pets, store]
+      responses:
+        "200":
+          description: OK
+components:
+  schemas:
+    Status:
+      enum: [active, inactive]
+    Pet:
+      allOf:
+        - $ref: "#/components/schemas/Base"
+        - type: object
+  anchors:
+    base: &base
+      type: string
+    alias: *base
+`
+
+func TestGetTarget_YamlNode_Success(t *testing.T) {
+	var root yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(testYamlDoc), &root))
+
+	tests := []struct {
+		name    string
+		source  any
+		pointer JSONPointer
+		want    string
+	}{
+		{
+			name:    "index into servers sequence",
+			source:  &root,
+			pointer: JSONPointer("/servers/1/url"),
+			want:    "https://staging.example.com",
+		},
+		{
+			name:    "index into tags sequence",
+			source:  &root,
+			pointer: JSONPointer("/paths/~1x/get/tags/0"),
+			want:    "pets",
+		},
+		{
+			name:    "numeric key into mapping",
+			source:  &root,
+			pointer: JSONPointer("/paths/~1x/get/responses/200/description"),
+			want:    "OK",
+		},
+		{
+			name:    "index into enum sequence",
+			source:  &root,
+			pointer: JSONPointer("/components/schemas/Status/enum/1"),
+			want:    "inactive",
+		},
+		{
+			name:    "index into allOf sequence",
+			source:  &root,
+			pointer: JSONPointer("/components/schemas/Pet/allOf/0/$ref"),
+			want:    "#/components/schemas/Base",
+		},
+		{
+			name:    "through alias node",
+			source:  &root,
+			pointer: JSONPointer("/components/anchors/alias/type"),
+			want:    "string",
+		},
+		{
+			name:    "non pointer node",
+			source:  root,
+			pointer: JSONPointer("/openapi"),
+			want:    "3.1.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := GetTarget(tt.source, tt.pointer)
+			require.NoError(t, err)
+
+			node, ok := target.(*yaml.Node)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, node.Value)
+		})
+	}
+}
+
+func TestGetTarget_YamlNode_Error(t *testing.T) {
+	var root yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(testYamlDoc), &root))
+
+	tests := []struct {
+		name    string
+		pointer JSONPointer
+		wantErr error
+	}{
+		{
+			name:    "key into sequence",
+			pointer: JSONPointer("/servers/first"),
+			wantErr: errors.New("invalid path -- expected index for sequence node, got key at /servers/first"),
+		},
+		{
+			name:    "index out of range",
+			pointer: JSONPointer("/servers/2"),
+			wantErr: errors.New("not found -- index 2 out of range for sequence node of length 2 at /servers/2"),
+		},
+		{
+			name:    "key not found",
+			pointer: JSONPointer("/paths/~1x/get/responses/404"),
+			wantErr: errors.New("not found -- key 404 not found in mapping node at /paths/~1x/get/responses/404"),
+		},
+		{
+			name:    "navigating into scalar",
+			pointer: JSONPointer("/openapi/version"),
+			wantErr: errors.New("invalid path -- expected mapping or sequence node, got scalar node at /openapi/version"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := GetTarget(&root, tt.pointer)
+			assert.EqualError(t, err, tt.wantErr.Error())
+			assert.Nil(t, target)
+		})
+	}
+}
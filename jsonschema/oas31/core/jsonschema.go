@@ -29,6 +29,7 @@ type Schema struct {
 	Else                  marshaller.Node[JSONSchema]                            `key:"else"`
 	Then                  marshaller.Node[JSONSchema]                            `key:"then"`
 	DependentSchemas      marshaller.Node[*sequencedmap.Map[string, JSONSchema]] `key:"dependentSchemas"`
+	DependentRequired     marshaller.Node[*sequencedmap.Map[string, []Value]]    `key:"dependentRequired"`
 	PatternProperties     marshaller.Node[*sequencedmap.Map[string, JSONSchema]] `key:"patternProperties"`
 	PropertyNames         marshaller.Node[JSONSchema]                            `key:"propertyNames"`
 	UnevaluatedItems      marshaller.Node[JSONSchema]                            `key:"unevaluatedItems"`
@@ -45,6 +46,9 @@ type Schema struct {
 	MinLength             marshaller.Node[*int64]                                `key:"minLength"`
 	Pattern               marshaller.Node[*string]                               `key:"pattern"`
 	Format                marshaller.Node[*string]                               `key:"format"`
+	ContentEncoding       marshaller.Node[*string]                               `key:"contentEncoding"`
+	ContentMediaType      marshaller.Node[*string]                               `key:"contentMediaType"`
+	ContentSchema         marshaller.Node[JSONSchema]                            `key:"contentSchema"`
 	MaxItems              marshaller.Node[*int64]                                `key:"maxItems"`
 	MinItems              marshaller.Node[*int64]                                `key:"minItems"`
 	UniqueItems           marshaller.Node[*bool]                                 `key:"uniqueItems"`
@@ -60,9 +64,16 @@ type Schema struct {
 	ReadOnly              marshaller.Node[*bool]                                 `key:"readOnly"`
 	WriteOnly             marshaller.Node[*bool]                                 `key:"writeOnly"`
 	ExternalDocs          marshaller.Node[*ExternalDoc]                          `key:"externalDocs"`
+	XML                   marshaller.Node[*XML]                                  `key:"xml"`
 	Example               marshaller.Node[Value]                                 `key:"example"`
 	Deprecated            marshaller.Node[*bool]                                 `key:"deprecated"`
 	Schema                marshaller.Node[*string]                               `key:"$schema"`
+	ID                    marshaller.Node[*string]                               `key:"$id"`
+	Comment               marshaller.Node[*string]                               `key:"$comment"`
+	Defs                  marshaller.Node[*sequencedmap.Map[string, JSONSchema]] `key:"$defs"`
+	Vocabulary            marshaller.Node[*sequencedmap.Map[string, bool]]       `key:"$vocabulary"`
+	DynamicRef            marshaller.Node[*string]                               `key:"$dynamicRef"`
+	DynamicAnchor         marshaller.Node[*string]                               `key:"$dynamicAnchor"`
 
 	Extensions core.Extensions `key:"extensions"`
 
@@ -0,0 +1,26 @@
+package core
+
+import (
+	"context"
+
+	"github.com/speakeasy-api/openapi/extensions/core"
+	"github.com/speakeasy-api/openapi/marshaller"
+	"gopkg.in/yaml.v3"
+)
+
+type XML struct {
+	Name       marshaller.Node[*string] `key:"name"`
+	Namespace  marshaller.Node[*string] `key:"namespace"`
+	Prefix     marshaller.Node[*string] `key:"prefix"`
+	Attribute  marshaller.Node[*bool]   `key:"attribute"`
+	Wrapped    marshaller.Node[*bool]   `key:"wrapped"`
+	Extensions core.Extensions          `key:"extensions"`
+
+	RootNode *yaml.Node
+}
+
+func (x *XML) Unmarshal(ctx context.Context, node *yaml.Node) error {
+	x.RootNode = node
+
+	return marshaller.UnmarshalStruct(ctx, node, x)
+}
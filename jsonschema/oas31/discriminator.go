@@ -2,6 +2,7 @@ package oas31
 
 import (
 	"github.com/speakeasy-api/openapi/extensions"
+	"github.com/speakeasy-api/openapi/jsonschema/oas31/core"
 	"github.com/speakeasy-api/openapi/sequencedmap"
 )
 
@@ -9,4 +10,10 @@ type Discriminator struct {
 	PropertyName string
 	Mapping      *sequencedmap.Map[string, string]
 	Extensions   *extensions.Extensions
+
+	core core.Discriminator
+}
+
+func (d *Discriminator) GetCore() *core.Discriminator {
+	return &d.core
 }
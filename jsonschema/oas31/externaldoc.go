@@ -1,9 +1,18 @@
 package oas31
 
-import "github.com/speakeasy-api/openapi/extensions"
+import (
+	"github.com/speakeasy-api/openapi/extensions"
+	"github.com/speakeasy-api/openapi/jsonschema/oas31/core"
+)
 
 type ExternalDoc struct {
 	Description *string
 	URL         string
 	Extensions  *extensions.Extensions
+
+	core core.ExternalDoc
+}
+
+func (e *ExternalDoc) GetCore() *core.ExternalDoc {
+	return &e.core
 }
@@ -44,6 +44,7 @@ type Schema struct {
 	Else                  JSONSchema
 	Then                  JSONSchema
 	DependentSchemas      *sequencedmap.Map[string, JSONSchema]
+	DependentRequired     *sequencedmap.Map[string, []Value]
 	PatternProperties     *sequencedmap.Map[string, JSONSchema]
 	PropertyNames         JSONSchema
 	UnevaluatedItems      JSONSchema
@@ -60,6 +61,9 @@ type Schema struct {
 	MinLength             *int64
 	Pattern               *string
 	Format                *string
+	ContentEncoding       *string
+	ContentMediaType      *string
+	ContentSchema         JSONSchema
 	MaxItems              *int64
 	MinItems              *int64
 	UniqueItems           *bool
@@ -75,9 +79,16 @@ type Schema struct {
 	ReadOnly              *bool
 	WriteOnly             *bool
 	ExternalDocs          *ExternalDoc
+	XML                   *XML
 	Example               Value
 	Deprecated            *bool
 	Schema                *string
+	ID                    *string
+	Comment               *string
+	Defs                  *sequencedmap.Map[string, JSONSchema]
+	Vocabulary            *sequencedmap.Map[string, bool]
+	DynamicRef            *string
+	DynamicAnchor         *string
 	Extensions            *extensions.Extensions
 
 	Valid bool
@@ -0,0 +1,71 @@
+package oas31_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/speakeasy-api/openapi/marshaller"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSchema_RoundTrip_Success(t *testing.T) {
+	ctx := context.Background()
+
+	data := `$schema: https://json-schema.org/draft/2020-12/schema
+$id: https://example.com/schemas/pet
+$comment: Used by the pets API
+$dynamicAnchor: node
+type: object
+properties:
+  name:
+    type: string
+  children:
+    type: array
+    items:
+      $dynamicRef: '#node'
+  image:
+    type: string
+    contentEncoding: base64
+    contentMediaType: image/png
+  document:
+    type: string
+    contentMediaType: application/json
+    contentSchema:
+      type: object
+dependentRequired:
+  image:
+    - name
+$defs:
+  id:
+    type: string
+unevaluatedProperties: false
+`
+
+	js := unmarshalSchema(t, data)
+
+	assert.Equal(t, "https://example.com/schemas/pet", *js.ID)
+	assert.Equal(t, "Used by the pets API", *js.Comment)
+	assert.Equal(t, "node", *js.DynamicAnchor)
+	assert.Equal(t, "#node", *js.Properties.GetOrZero("children").Left.Items.Left.DynamicRef)
+	assert.Equal(t, "base64", *js.Properties.GetOrZero("image").Left.ContentEncoding)
+	assert.Equal(t, "image/png", *js.Properties.GetOrZero("image").Left.ContentMediaType)
+	assert.True(t, js.Properties.GetOrZero("document").Left.ContentSchema.IsLeft())
+	require.Len(t, js.DependentRequired.GetOrZero("image"), 1)
+	assert.Equal(t, "name", js.DependentRequired.GetOrZero("image")[0].Value)
+	assert.True(t, js.Defs.Has("id"))
+	require.True(t, js.UnevaluatedProperties.IsRight())
+	assert.False(t, js.UnevaluatedProperties.GetRight())
+
+	rootNode, err := marshaller.SyncValue(ctx, js, js.GetCore(), js.GetCore().RootNode, false)
+	require.NoError(t, err)
+
+	buf := bytes.NewBuffer([]byte{})
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	require.NoError(t, enc.Encode(rootNode))
+
+	assert.Equal(t, data, buf.String())
+}
@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
 
 	_ "embed"
 
@@ -11,6 +15,7 @@ import (
 	"github.com/speakeasy-api/openapi/json"
 	"github.com/speakeasy-api/openapi/jsonpointer"
 	"github.com/speakeasy-api/openapi/jsonschema/oas31/core"
+	"github.com/speakeasy-api/openapi/sequencedmap"
 	"github.com/speakeasy-api/openapi/validation"
 	"gopkg.in/yaml.v3"
 )
@@ -26,6 +31,8 @@ var oasSchemaValidator *jsValidator.Schema
 func (js *Schema) Validate(ctx context.Context, opts ...validation.Option) []error {
 	// TODO we maybe need to unset any $schema node as it will potentially change how the schema is validated
 
+	o := validation.NewOptions(opts...)
+
 	buf := bytes.NewBuffer([]byte{})
 
 	if err := json.YAMLToJSON(js.core.RootNode, 0, buf); err != nil {
@@ -49,11 +56,13 @@ func (js *Schema) Validate(ctx context.Context, opts ...validation.Option) []err
 		}
 	}
 
+	errs := []error{}
+
 	err = oasSchemaValidator.Validate(jsAny)
 	if err != nil {
 		var validationErr *jsValidator.ValidationError
 		if errors.As(err, &validationErr) {
-			return getRootCauses(validationErr, js.core)
+			errs = append(errs, getRootCauses(validationErr, js.core)...)
 		} else {
 			return []error{
 				validation.Error{
@@ -65,11 +74,352 @@ func (js *Schema) Validate(ctx context.Context, opts ...validation.Option) []err
 		}
 	}
 
+	// Semantic checks assume the schema is structurally valid, so only run them once the meta-schema validation has passed
+	if len(errs) == 0 {
+		errs = append(errs, js.validateSemantics(ctx, false, opts...)...)
+	}
+
+	if len(errs) > 0 {
+		return o.TruncateErrors(errs)
+	}
+
 	js.Valid = true
 
 	return nil
 }
 
+// validateSemantics validates the schema and its subschemas against the rules of the OpenAPI Specification that can't be expressed by the meta-schema.
+// composed indicates the schema is a member of an allOf, oneOf or anyOf composition and may rely on its siblings or parent for some of its definition.
+func (js *Schema) validateSemantics(ctx context.Context, composed bool, opts ...validation.Option) []error {
+	errs := []error{}
+
+	if js.XML != nil {
+		errs = append(errs, js.XML.Validate(ctx, opts...)...)
+
+		if js.XML.Wrapped != nil && *js.XML.Wrapped && js.Type != nil && !js.hasType("array") {
+			errs = append(errs, &validation.Error{
+				Message: "xml.wrapped is only meaningful for array schemas",
+				Line:    js.XML.core.Wrapped.GetKeyNodeOrRoot(js.core.RootNode).Line,
+				Column:  js.XML.core.Wrapped.GetKeyNodeOrRoot(js.core.RootNode).Column,
+			})
+		}
+
+		if js.XML.Attribute != nil && *js.XML.Attribute && (js.hasType("object") || js.hasType("array")) {
+			errs = append(errs, &validation.Error{
+				Message: "xml.attribute is only meaningful for primitive schemas",
+				Line:    js.XML.core.Attribute.GetKeyNodeOrRoot(js.core.RootNode).Line,
+				Column:  js.XML.core.Attribute.GetKeyNodeOrRoot(js.core.RootNode).Column,
+			})
+		}
+	}
+
+	if len(js.Enum) > 0 || js.Const != nil || js.Default != nil {
+		valueSchema := js.compileValueSchema()
+
+		for i, value := range js.Enum {
+			errs = append(errs, js.validateValue("enum", value, js.core.Enum.GetSliceValueNodeOrRoot(i, js.core.RootNode), valueSchema)...)
+		}
+		if js.Const != nil {
+			errs = append(errs, js.validateValue("const", js.Const, js.core.Const.GetValueNodeOrRoot(js.core.RootNode), valueSchema)...)
+		}
+		if js.Default != nil {
+			errs = append(errs, js.validateValue("default", js.Default, js.core.Default.GetValueNodeOrRoot(js.core.RootNode), valueSchema)...)
+		}
+	}
+
+	// A base schema declaring the discriminator's property is selected between by the allOf of the schemas that extend it, which can't be seen from here
+	if js.Discriminator != nil && len(js.AllOf) == 0 && len(js.OneOf) == 0 && len(js.AnyOf) == 0 && !js.Properties.Has(js.Discriminator.PropertyName) {
+		errs = append(errs, &validation.Error{
+			Message: "discriminator is only meaningful with oneOf, anyOf or allOf",
+			Line:    js.core.Discriminator.GetKeyNodeOrRoot(js.core.RootNode).Line,
+			Column:  js.core.Discriminator.GetKeyNodeOrRoot(js.core.RootNode).Column,
+		})
+	}
+
+	if !composed {
+		errs = append(errs, js.validateRequiredProperties()...)
+	}
+
+	for _, subSchema := range js.getSubSchemas() {
+		if subSchema == nil || !subSchema.IsLeft() {
+			continue
+		}
+
+		if subSchema.Left.Vocabulary != nil {
+			errs = append(errs, &validation.Error{
+				Message: "$vocabulary is only meaningful at the root of a meta-schema",
+				Line:    subSchema.Left.core.Vocabulary.GetKeyNodeOrRoot(subSchema.Left.core.RootNode).Line,
+				Column:  subSchema.Left.core.Vocabulary.GetKeyNodeOrRoot(subSchema.Left.core.RootNode).Column,
+			})
+		}
+
+		// contains is a partial constraint on some of the array's items, whose properties are declared by the items schema rather than the contains schema itself
+		composed := js.isComposedSubSchema(subSchema) || subSchema == js.Contains
+
+		errs = append(errs, subSchema.Left.validateSemantics(ctx, composed, opts...)...)
+	}
+
+	return errs
+}
+
+// isComposedSubSchema reports whether the subschema applies to the same instance as this schema (ie via composition, conditionals, negation or dependent schemas),
+// and so may rely on this schema to declare the properties it references.
+func (js *Schema) isComposedSubSchema(subSchema JSONSchema) bool {
+	if slices.Contains(js.AllOf, subSchema) || slices.Contains(js.OneOf, subSchema) || slices.Contains(js.AnyOf, subSchema) {
+		return true
+	}
+
+	if slices.Contains([]JSONSchema{js.If, js.Then, js.Else, js.Not}, subSchema) {
+		return true
+	}
+
+	if js.DependentSchemas != nil {
+		for _, dependentSchema := range js.DependentSchemas.All() {
+			if dependentSchema == subSchema {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateRequiredProperties validates that each required property is declared in the schema's properties.
+// Schemas that could otherwise supply the property, via composition, references, conditionals or additional/pattern properties, are skipped.
+func (js *Schema) validateRequiredProperties() []error {
+	if len(js.Required) == 0 {
+		return nil
+	}
+
+	if js.Ref != nil || len(js.AllOf) > 0 || len(js.OneOf) > 0 || len(js.AnyOf) > 0 || js.If != nil || js.DependentSchemas != nil || js.PatternProperties.Len() > 0 {
+		return nil
+	}
+
+	for _, additional := range []JSONSchema{js.AdditionalProperties, js.UnevaluatedProperties} {
+		if additional != nil && (additional.IsLeft() || additional.GetRight()) {
+			return nil
+		}
+	}
+
+	errs := []error{}
+
+	for i, name := range js.Required {
+		if js.Properties.Has(name) {
+			continue
+		}
+
+		errs = append(errs, &validation.Error{
+			Message: fmt.Sprintf("required property %s is not defined in properties", name),
+			Line:    js.core.Required.GetSliceValueNodeOrRoot(i, js.core.RootNode).Line,
+			Column:  js.core.Required.GetSliceValueNodeOrRoot(i, js.core.RootNode).Column,
+		})
+	}
+
+	return errs
+}
+
+// validateValue validates that a value declared by the schema (ie an enum, const or default value) is compatible with the schema's type
+// and satisfies the schema's other constraints (ie minimum or maxLength) as validated by valueSchema, if the schema could be compiled on its own.
+func (js *Schema) validateValue(keyword string, value Value, valueNode *yaml.Node, valueSchema *jsValidator.Schema) []error {
+	if errs := js.validateValueType(keyword, value, valueNode); len(errs) > 0 {
+		return errs
+	}
+
+	if valueSchema == nil || value == nil {
+		return nil
+	}
+
+	// nullable isn't understood by the validator so allow null values here as validateValueType will have already checked them
+	if js.Nullable != nil && *js.Nullable && getValueType(value) == "null" {
+		return nil
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	if err := json.YAMLToJSON(value, 0, buf); err != nil {
+		return nil
+	}
+
+	v, err := jsValidator.UnmarshalJSON(buf)
+	if err != nil {
+		return nil
+	}
+
+	var validationErr *jsValidator.ValidationError
+	if !errors.As(valueSchema.Validate(v), &validationErr) {
+		return nil
+	}
+
+	errs := []error{}
+
+	for _, cause := range getLeafCauses(validationErr) {
+		errs = append(errs, &validation.Error{
+			Message: fmt.Sprintf("%s value does not match schema: %s", keyword, cause.Error()),
+			Line:    valueNode.Line,
+			Column:  valueNode.Column,
+		})
+	}
+
+	return errs
+}
+
+// compileValueSchema compiles the schema without its enum and const keywords, so the values it declares can be validated against its other constraints
+// without being checked against themselves. Returns nil if the schema can't be compiled on its own (ie it contains references to other schemas).
+func (js *Schema) compileValueSchema() *jsValidator.Schema {
+	if js.core.RootNode == nil || js.core.RootNode.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	node := *js.core.RootNode
+	node.Content = nil
+
+	for i := 0; i+1 < len(js.core.RootNode.Content); i += 2 {
+		switch js.core.RootNode.Content[i].Value {
+		case "enum", "const":
+			continue
+		}
+
+		node.Content = append(node.Content, js.core.RootNode.Content[i], js.core.RootNode.Content[i+1])
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	if err := json.YAMLToJSON(&node, 0, buf); err != nil {
+		return nil
+	}
+
+	doc, err := jsValidator.UnmarshalJSON(buf)
+	if err != nil {
+		return nil
+	}
+
+	c := jsValidator.NewCompiler()
+	c.DefaultDraft(jsValidator.Draft2020)
+	if err := c.AddResource("value.json", doc); err != nil {
+		return nil
+	}
+
+	valueSchema, err := c.Compile("value.json")
+	if err != nil {
+		return nil
+	}
+
+	return valueSchema
+}
+
+func getLeafCauses(err *jsValidator.ValidationError) []*jsValidator.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsValidator.ValidationError{err}
+	}
+
+	causes := []*jsValidator.ValidationError{}
+	for _, cause := range err.Causes {
+		causes = append(causes, getLeafCauses(cause)...)
+	}
+
+	return causes
+}
+
+// validateValueType validates that a value declared by the schema (ie an enum, const or default value) is compatible with the schema's type.
+// Schemas without a type are skipped.
+func (js *Schema) validateValueType(keyword string, value Value, valueNode *yaml.Node) []error {
+	types := js.getTypes()
+	if len(types) == 0 || value == nil {
+		return nil
+	}
+
+	if js.Nullable != nil && *js.Nullable {
+		types = append(types, "null")
+	}
+
+	valueType := getValueType(value)
+
+	for _, typ := range types {
+		if typ == valueType || (typ == "number" && valueType == "integer") || (typ == "integer" && valueType == "number" && isIntegral(value)) {
+			return nil
+		}
+	}
+
+	return []error{
+		&validation.Error{
+			Message: fmt.Sprintf("%s value of type %s does not match schema type [%s]", keyword, valueType, strings.Join(types, ", ")),
+			Line:    valueNode.Line,
+			Column:  valueNode.Column,
+		},
+	}
+}
+
+func getValueType(value Value) string {
+	for value.Kind == yaml.AliasNode && value.Alias != nil {
+		value = value.Alias
+	}
+
+	switch value.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+
+	switch value.ShortTag() {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	default:
+		return "string"
+	}
+}
+
+func isIntegral(value Value) bool {
+	var f float64
+	if err := value.Decode(&f); err != nil {
+		return false
+	}
+
+	return f == math.Trunc(f)
+}
+
+func (js *Schema) getSubSchemas() []JSONSchema {
+	subSchemas := []JSONSchema{}
+
+	subSchemas = append(subSchemas, js.AllOf...)
+	subSchemas = append(subSchemas, js.OneOf...)
+	subSchemas = append(subSchemas, js.AnyOf...)
+	subSchemas = append(subSchemas, js.PrefixItems...)
+	subSchemas = append(subSchemas, js.Contains, js.If, js.Else, js.Then, js.PropertyNames, js.UnevaluatedItems, js.UnevaluatedProperties, js.Items, js.Not, js.AdditionalProperties, js.ContentSchema)
+
+	for _, m := range []*sequencedmap.Map[string, JSONSchema]{js.DependentSchemas, js.PatternProperties, js.Properties, js.Defs} {
+		if m == nil {
+			continue
+		}
+
+		for _, subSchema := range m.All() {
+			subSchemas = append(subSchemas, subSchema)
+		}
+	}
+
+	return subSchemas
+}
+
+func (js *Schema) getTypes() []string {
+	if js.Type == nil {
+		return nil
+	}
+
+	if js.Type.IsLeft() {
+		return slices.Clone(js.Type.GetLeft())
+	}
+
+	return []string{js.Type.GetRight()}
+}
+
+func (js *Schema) hasType(typ string) bool {
+	return slices.Contains(js.getTypes(), typ)
+}
+
 type marshallerNode interface {
 	GetKeyNodeOrRoot(rootNode *yaml.Node) *yaml.Node
 }
@@ -79,24 +429,17 @@ func getRootCauses(err *jsValidator.ValidationError, js core.Schema) []error {
 
 	for _, cause := range err.Causes {
 		if len(cause.Causes) == 0 {
-			errJP := jsonpointer.PartsToJSONPointer(cause.InstanceLocation)
-			t, err := jsonpointer.GetTarget(js, errJP, jsonpointer.WithStructTags("key"))
+			node, err := getInstanceNode(js, cause.InstanceLocation)
 			if err != nil {
 				// TODO need to potentially handle this in another way
 				errs = append(errs, err)
 				continue
 			}
 
-			mn, ok := t.(marshallerNode)
-			if !ok {
-				// TODO will this be possible? Maybe if the issue is in an extension?
-				panic(errors.New("expected marshallerNode"))
-			}
-
 			errs = append(errs, &validation.Error{
 				Message: "jsonschema validation error: " + cause.Error(),
-				Line:    mn.GetKeyNodeOrRoot(js.RootNode).Line,
-				Column:  mn.GetKeyNodeOrRoot(js.RootNode).Column,
+				Line:    node.Line,
+				Column:  node.Column,
 			})
 		} else {
 			errs = append(errs, getRootCauses(cause, js)...)
@@ -106,6 +449,27 @@ func getRootCauses(err *jsValidator.ValidationError, js core.Schema) []error {
 	return errs
 }
 
+// getInstanceNode returns the node at the location of a validation error. Locations that resolve to plain values without a node of their own
+// fall back to the nearest parent with a node, or the schema's root node.
+func getInstanceNode(js core.Schema, location []string) (*yaml.Node, error) {
+	for i := len(location); i > 0; i-- {
+		t, err := jsonpointer.GetTarget(js, jsonpointer.PartsToJSONPointer(location[:i]), jsonpointer.WithStructTags("key"))
+		if err != nil {
+			return nil, err
+		}
+
+		switch tn := t.(type) {
+		case marshallerNode:
+			return tn.GetKeyNodeOrRoot(js.RootNode), nil
+		case *yaml.Node:
+			// The error is within a raw value (ie an example or default) so we can point directly at the node
+			return tn, nil
+		}
+	}
+
+	return js.RootNode, nil
+}
+
 func init() {
 	oasSchema, err := jsValidator.UnmarshalJSON(bytes.NewReader([]byte(schemaJSON)))
 	if err != nil {
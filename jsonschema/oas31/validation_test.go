@@ -0,0 +1,467 @@
+package oas31_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/speakeasy-api/openapi/jsonschema/oas31"
+	"github.com/speakeasy-api/openapi/jsonschema/oas31/core"
+	"github.com/speakeasy-api/openapi/marshaller"
+	"github.com/speakeasy-api/openapi/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSchema_Validate_Success(t *testing.T) {
+	tests := []struct {
+		name string
+		yml  string
+	}{
+		{
+			name: "xml object",
+			yml: `type: object
+properties:
+  pets:
+    type: array
+    items:
+      type: string
+      xml:
+        name: pet
+    xml:
+      name: pets
+      namespace: https://example.com/schema/pets
+      prefix: ex
+      wrapped: true
+  id:
+    type: integer
+    xml:
+      attribute: true
+`,
+		},
+		{
+			name: "enum, const and default values match type",
+			yml: `type: object
+properties:
+  status:
+    type: string
+    enum: [active, "1", inactive]
+    default: active
+  count:
+    type: integer
+    enum: [1, 2, 3.0]
+  ratio:
+    type: number
+    enum: [1, 2.5]
+  flag:
+    type: [boolean, "null"]
+    enum: [true, null]
+  nullable:
+    type: string
+    nullable: true
+    enum: [a, null]
+  version:
+    const: 1
+  tags:
+    type: array
+    default: [a, b]
+  metadata:
+    type: object
+    default:
+      key: value
+  code:
+    type: string
+    maxLength: 2
+    enum: [ab, cd]
+    default: ab
+  count:
+    type: integer
+    minimum: 10
+    default: 10
+  pet:
+    $ref: "#/$defs/Pet"
+    default:
+      name: fido
+`,
+		},
+		{
+			name: "required properties are declared or supplied by composition",
+			yml: `type: object
+required: [id]
+properties:
+  id:
+    type: string
+  pet:
+    allOf:
+      - type: object
+        properties:
+          name:
+            type: string
+      - required: [name]
+    required: [name]
+  owner:
+    type: object
+    required: [name]
+    additionalProperties:
+      type: string
+  labels:
+    type: object
+    required: [name]
+    patternProperties:
+      "^n":
+        type: string
+`,
+		},
+		{
+			name: "required properties in conditional subschemas are declared by the parent",
+			yml: `type: object
+properties:
+  method:
+    type: string
+  card:
+    type: string
+if:
+  properties:
+    method:
+      const: card
+then:
+  required: [card]
+else:
+  required: [method]
+`,
+		},
+		{
+			name: "required properties in not are declared by the parent",
+			yml: `type: object
+properties:
+  a:
+    type: string
+  b:
+    type: string
+not:
+  required: [a, b]
+`,
+		},
+		{
+			name: "required properties in dependent schemas are declared by the parent",
+			yml: `type: object
+properties:
+  a:
+    type: string
+  b:
+    type: string
+dependentSchemas:
+  a:
+    required: [b]
+`,
+		},
+		{
+			name: "required properties in contains are declared by items",
+			yml: `type: array
+items:
+  type: object
+  properties:
+    id:
+      type: string
+contains:
+  required: [id]
+`,
+		},
+		{
+			name: "discriminator with composition",
+			yml: `oneOf:
+  - $ref: "#/$defs/Cat"
+  - $ref: "#/$defs/Dog"
+discriminator:
+  propertyName: petType
+  mapping:
+    cat: "#/$defs/Cat"
+    dog: "#/$defs/Dog"
+externalDocs:
+  url: https://example.com/docs/pets
+$defs:
+  Cat:
+    type: object
+  Dog:
+    type: object
+`,
+		},
+		{
+			name: "discriminator on a base schema declaring its property",
+			yml: `$defs:
+  Pet:
+    type: object
+    required: [petType]
+    properties:
+      petType:
+        type: string
+    discriminator:
+      propertyName: petType
+      mapping:
+        dog: Dog
+  Cat:
+    allOf:
+      - $ref: "#/$defs/Pet"
+      - type: object
+        properties:
+          name:
+            type: string
+  Dog:
+    allOf:
+      - $ref: "#/$defs/Pet"
+      - type: object
+        properties:
+          bark:
+            type: string
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := unmarshalSchema(t, tt.yml)
+
+			errs := js.Validate(context.Background())
+			assert.Empty(t, errs)
+			assert.True(t, js.Valid)
+		})
+	}
+}
+
+func TestSchema_Validate_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		yml     string
+		wantErr []error
+	}{
+		{
+			name: "xml namespace is not a valid URI",
+			yml: `type: object
+properties:
+  pets:
+    type: array
+    xml:
+      namespace: not a uri
+`,
+			wantErr: []error{
+				&validation.Error{Line: 6, Column: 18, Message: "xml.namespace must be a valid absolute URI: not a uri"},
+			},
+		},
+		{
+			name: "xml namespace is a relative URI",
+			yml: `type: string
+xml:
+  namespace: /schema/pets
+`,
+			wantErr: []error{
+				&validation.Error{Line: 3, Column: 14, Message: "xml.namespace must be a valid absolute URI: /schema/pets"},
+			},
+		},
+		{
+			name: "xml wrapped on non array schema",
+			yml: `type: object
+xml:
+  wrapped: true
+`,
+			wantErr: []error{
+				&validation.Error{Line: 3, Column: 3, Message: "xml.wrapped is only meaningful for array schemas"},
+			},
+		},
+		{
+			name: "xml attribute on object schema",
+			yml: `type: [object, "null"]
+xml:
+  attribute: true
+`,
+			wantErr: []error{
+				&validation.Error{Line: 3, Column: 3, Message: "xml.attribute is only meaningful for primitive schemas"},
+			},
+		},
+		{
+			name: "xml wrapped of the wrong type is only reported by the meta-schema",
+			yml: `type: object
+xml:
+  wrapped: "yes"
+`,
+			wantErr: []error{
+				&validation.Error{Line: 3, Column: 3, Message: "jsonschema validation error: at '/xml/wrapped': got string, want boolean"},
+			},
+		},
+		{
+			name: "type of the wrong type is only reported by the meta-schema",
+			yml: `type: 5
+enum: [a]
+`,
+			wantErr: []error{
+				&validation.Error{Line: 1, Column: 1, Message: "jsonschema validation error: at '/type': value must be one of 'array', 'boolean', 'integer', 'null', 'number', 'object', 'string'"},
+				&validation.Error{Line: 1, Column: 1, Message: "jsonschema validation error: at '/type': got number, want array"},
+			},
+		},
+		{
+			name: "duplicate dependentRequired entries",
+			yml: `type: object
+dependentRequired:
+  a: [b, b]
+`,
+			wantErr: []error{
+				&validation.Error{Line: 2, Column: 1, Message: "jsonschema validation error: at '/dependentRequired/a': items at 0 and 1 are equal"},
+			},
+		},
+		{
+			name: "dependentRequired entry of the wrong type",
+			yml: `type: object
+dependentRequired:
+  a: [b, 1]
+`,
+			wantErr: []error{
+				&validation.Error{Line: 3, Column: 10, Message: "jsonschema validation error: at '/dependentRequired/a/1': got number, want string"},
+			},
+		},
+		{
+			name: "string enum values under integer type",
+			yml: `type: integer
+enum: ["a", 1, b]
+`,
+			wantErr: []error{
+				&validation.Error{Line: 2, Column: 8, Message: "enum value of type string does not match schema type [integer]"},
+				&validation.Error{Line: 2, Column: 16, Message: "enum value of type string does not match schema type [integer]"},
+			},
+		},
+		{
+			name: "values not matching schema constraints",
+			yml: `type: object
+properties:
+  code:
+    type: string
+    maxLength: 2
+    enum: [ab, abcdef]
+  count:
+    type: integer
+    minimum: 10
+    default: 1
+  tags:
+    type: array
+    items:
+      type: string
+    const: [a, 1]
+`,
+			wantErr: []error{
+				&validation.Error{Line: 6, Column: 16, Message: "enum value does not match schema: at '': maxLength: got 6, want 2"},
+				&validation.Error{Line: 10, Column: 14, Message: "default value does not match schema: at '': minimum: got 1, want 10"},
+				&validation.Error{Line: 15, Column: 12, Message: "const value does not match schema: at '/1': got number, want string"},
+			},
+		},
+		{
+			name: "fractional enum value under integer type",
+			yml: `type: integer
+enum: [1.5]
+`,
+			wantErr: []error{
+				&validation.Error{Line: 2, Column: 8, Message: "enum value of type number does not match schema type [integer]"},
+			},
+		},
+		{
+			name: "const and default values not matching type",
+			yml: `type: object
+properties:
+  name:
+    type: string
+    const: 1
+  tags:
+    type: [array, "null"]
+    default: a
+`,
+			wantErr: []error{
+				&validation.Error{Line: 5, Column: 12, Message: "const value of type integer does not match schema type [string]"},
+				&validation.Error{Line: 8, Column: 14, Message: "default value of type string does not match schema type [array, null]"},
+			},
+		},
+		{
+			name: "$vocabulary in subschema",
+			yml: `type: object
+properties:
+  name:
+    type: string
+    $vocabulary:
+      https://json-schema.org/draft/2020-12/vocab/core: true
+`,
+			wantErr: []error{
+				&validation.Error{Line: 5, Column: 5, Message: "$vocabulary is only meaningful at the root of a meta-schema"},
+			},
+		},
+		{
+			name: "required property not declared",
+			yml: `type: object
+required: [id, name]
+properties:
+  id:
+    type: string
+  owner:
+    type: object
+    required: [name]
+    additionalProperties: false
+`,
+			wantErr: []error{
+				&validation.Error{Line: 2, Column: 16, Message: "required property name is not defined in properties"},
+				&validation.Error{Line: 8, Column: 16, Message: "required property name is not defined in properties"},
+			},
+		},
+		{
+			name: "discriminator without composition",
+			yml: `type: object
+properties:
+  pet:
+    type: object
+    properties:
+      name:
+        type: string
+    discriminator:
+      propertyName: petType
+`,
+			wantErr: []error{
+				&validation.Error{Line: 8, Column: 5, Message: "discriminator is only meaningful with oneOf, anyOf or allOf"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := unmarshalSchema(t, tt.yml)
+
+			errs := js.Validate(context.Background())
+			assert.Equal(t, tt.wantErr, errs)
+			assert.False(t, js.Valid)
+		})
+	}
+}
+
+func unmarshalSchema(t *testing.T, data string) *oas31.Schema {
+	t.Helper()
+
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(data), &node))
+
+	var c core.Schema
+	require.NoError(t, marshaller.Unmarshal(context.Background(), &node, &c))
+
+	var js oas31.Schema
+	require.NoError(t, marshaller.PopulateModel(c, &js))
+
+	return &js
+}
+
+func TestSchema_Validate_MaxErrors(t *testing.T) {
+	js := unmarshalSchema(t, `type: object
+required: [a, b, c]
+properties:
+  id:
+    type: string
+`)
+
+	errs := js.Validate(context.Background(), validation.WithMaxErrors(2))
+	assert.Equal(t, []error{
+		&validation.Error{Line: 2, Column: 12, Message: "required property a is not defined in properties"},
+		&validation.Error{Line: 2, Column: 15, Message: "required property b is not defined in properties"},
+		&validation.TruncatedError{MaxErrors: 2},
+	}, errs)
+	assert.True(t, validation.IsTruncated(errs))
+	assert.False(t, js.Valid)
+}
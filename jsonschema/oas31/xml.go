@@ -0,0 +1,44 @@
+package oas31
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/speakeasy-api/openapi/extensions"
+	"github.com/speakeasy-api/openapi/jsonschema/oas31/core"
+	"github.com/speakeasy-api/openapi/validation"
+)
+
+type XML struct {
+	Name       *string
+	Namespace  *string
+	Prefix     *string
+	Attribute  *bool
+	Wrapped    *bool
+	Extensions *extensions.Extensions
+
+	core core.XML
+}
+
+func (x *XML) GetCore() *core.XML {
+	return &x.core
+}
+
+// Validate will validate the XML object against the OpenAPI Specification.
+func (x *XML) Validate(ctx context.Context, opts ...validation.Option) []error {
+	errs := []error{}
+
+	if x.Namespace != nil {
+		u, err := url.Parse(*x.Namespace)
+		if err != nil || !u.IsAbs() {
+			errs = append(errs, &validation.Error{
+				Message: fmt.Sprintf("xml.namespace must be a valid absolute URI: %s", *x.Namespace),
+				Line:    x.core.Namespace.GetValueNodeOrRoot(x.core.RootNode).Line,
+				Column:  x.core.Namespace.GetValueNodeOrRoot(x.core.RootNode).Column,
+			})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,73 @@
+package marshaller
+
+import (
+	"context"
+
+	"gopkg.in/yaml.v3"
+)
+
+type contextKey string
+
+func (c contextKey) String() string {
+	return "marshaller-context-key-" + string(c)
+}
+
+const bestEffortContextKey = contextKey("best-effort")
+
+type bestEffortContext struct {
+	Errors         []error
+	SkippedIndexes map[*yaml.Node][]int
+}
+
+// ContextWithBestEffort returns a context that will cause unmarshalling to continue past errors encountered when unmarshalling individual fields.
+// Instead of being returned, the errors are collected on the context and can be retrieved with GetBestEffortErrors, and the affected fields are left unset.
+func ContextWithBestEffort(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bestEffortContextKey, &bestEffortContext{})
+}
+
+// GetBestEffortErrors returns the errors encountered while unmarshalling with a context returned by ContextWithBestEffort.
+func GetBestEffortErrors(ctx context.Context) []error {
+	bc, ok := ctx.Value(bestEffortContextKey).(*bestEffortContext)
+	if !ok {
+		return nil
+	}
+
+	return bc.Errors
+}
+
+func isBestEffort(ctx context.Context) bool {
+	_, ok := ctx.Value(bestEffortContextKey).(*bestEffortContext)
+	return ok
+}
+
+func addBestEffortError(ctx context.Context, err error) {
+	bc, ok := ctx.Value(bestEffortContextKey).(*bestEffortContext)
+	if !ok {
+		return
+	}
+
+	bc.Errors = append(bc.Errors, err)
+}
+
+// addBestEffortSkippedIndex records that the item at the index of the sequence node was skipped as it failed to unmarshal.
+func addBestEffortSkippedIndex(ctx context.Context, sequenceNode *yaml.Node, idx int) {
+	bc, ok := ctx.Value(bestEffortContextKey).(*bestEffortContext)
+	if !ok {
+		return
+	}
+
+	if bc.SkippedIndexes == nil {
+		bc.SkippedIndexes = make(map[*yaml.Node][]int)
+	}
+
+	bc.SkippedIndexes[sequenceNode] = append(bc.SkippedIndexes[sequenceNode], idx)
+}
+
+func getBestEffortSkippedIndexes(ctx context.Context, sequenceNode *yaml.Node) []int {
+	bc, ok := ctx.Value(bestEffortContextKey).(*bestEffortContext)
+	if !ok {
+		return nil
+	}
+
+	return bc.SkippedIndexes[sequenceNode]
+}
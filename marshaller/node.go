@@ -3,6 +3,7 @@ package marshaller
 import (
 	"context"
 	"reflect"
+	"slices"
 
 	"github.com/speakeasy-api/openapi/yml"
 	"gopkg.in/yaml.v3"
@@ -25,6 +26,9 @@ type Node[V any] struct {
 	Value     V
 	ValueNode *yaml.Node
 	Present   bool
+
+	// skippedIndexes are the indexes of any items in a sequence ValueNode that failed to unmarshal in best effort mode and are missing from the Value
+	skippedIndexes []int
 }
 
 var (
@@ -37,7 +41,13 @@ func (n *Node[V]) Unmarshal(ctx context.Context, keyNode, valueNode *yaml.Node)
 	n.KeyNode = keyNode
 	n.ValueNode = valueNode
 
-	return Unmarshal(ctx, valueNode, &n.Value)
+	if err := Unmarshal(ctx, valueNode, &n.Value); err != nil {
+		return err
+	}
+
+	n.skippedIndexes = getBestEffortSkippedIndexes(ctx, valueNode)
+
+	return nil
 }
 
 func (n Node[V]) GetValue() any {
@@ -51,6 +61,19 @@ func (n Node[V]) GetValueType() reflect.Type {
 func (n *Node[V]) SyncValue(ctx context.Context, key string, value any) (*yaml.Node, *yaml.Node, error) {
 	n.Key = key
 	n.KeyNode = yml.CreateOrUpdateKeyNode(ctx, key, n.KeyNode)
+
+	if len(n.skippedIndexes) > 0 && n.ValueNode != nil {
+		// Drop the items skipped when unmarshalling so the remaining items line up with the value being synced
+		content := []*yaml.Node{}
+		for i, itemNode := range n.ValueNode.Content {
+			if !slices.Contains(n.skippedIndexes, i) {
+				content = append(content, itemNode)
+			}
+		}
+		n.ValueNode.Content = content
+		n.skippedIndexes = nil
+	}
+
 	valueNode, err := SyncValue(ctx, value, &n.Value, n.ValueNode, false)
 	if err != nil {
 		return nil, nil, err
@@ -83,6 +106,13 @@ func (n Node[V]) GetSliceValueNodeOrRoot(idx int, rootNode *yaml.Node) *yaml.Nod
 		return rootNode
 	}
 
+	// Account for any items skipped when unmarshalling so the index refers to the same item as in the Value
+	for _, skipped := range n.skippedIndexes {
+		if skipped <= idx {
+			idx++
+		}
+	}
+
 	if idx < 0 || idx >= len(n.ValueNode.Content) {
 		return n.ValueNode
 	}
@@ -119,12 +119,36 @@ func UnmarshalStruct(ctx context.Context, node *yaml.Node, structPtr any) error
 
 			if extensionsField != nil {
 				if err := unmarshalExtension(keyNode, valueNode, *extensionsField); err != nil {
-					return err
+					if !isBestEffort(ctx) {
+						return err
+					}
+
+					addBestEffortError(ctx, &validation.Error{
+						Message: fmt.Sprintf("failed to unmarshal extension %s: %s", key, err.Error()),
+						Line:    valueNode.Line,
+						Column:  valueNode.Column,
+					})
 				}
 			}
 		} else {
 			if err := unmarshalNode(ctx, keyNode, valueNode, field.Name, field.Field); err != nil {
-				return err
+				if !isBestEffort(ctx) {
+					return err
+				}
+
+				var valueType reflect.Type
+				if nodeAccessor, ok := field.Field.Interface().(NodeAccessor); ok {
+					valueType = nodeAccessor.GetValueType()
+				}
+
+				addBestEffortError(ctx, &validation.Error{
+					Message: fmt.Sprintf("failed to unmarshal field %s: %s", key, describeUnmarshalFailure(valueType, valueNode)),
+					Line:    valueNode.Line,
+					Column:  valueNode.Column,
+				})
+
+				// Leave the field unset rather than partially populated so the rest of the document can still be used
+				field.Field.Set(reflect.Zero(field.Field.Type()))
 			}
 
 			foundFields.Set(key, true)
@@ -215,14 +239,30 @@ func unmarshalSequence(ctx context.Context, node *yaml.Node, out reflect.Value)
 		return fmt.Errorf("expected slice, got %s", out.Kind())
 	}
 
-	out.Set(reflect.MakeSlice(out.Type(), len(node.Content), len(node.Content)))
+	out.Set(reflect.MakeSlice(out.Type(), 0, len(node.Content)))
 
 	for i := 0; i < len(node.Content); i++ {
 		valueNode := node.Content[i]
 
-		if err := unmarshal(ctx, valueNode, out.Index(i)); err != nil {
-			return err
+		valueOut := reflect.New(out.Type().Elem()).Elem()
+
+		if err := unmarshal(ctx, valueNode, valueOut); err != nil {
+			if !isBestEffort(ctx) {
+				return err
+			}
+
+			// Skip the item rather than failing the whole sequence so the rest of the document can still be used
+			addBestEffortError(ctx, &validation.Error{
+				Message: fmt.Sprintf("failed to unmarshal item %d: %s", i, describeUnmarshalFailure(out.Type().Elem(), valueNode)),
+				Line:    valueNode.Line,
+				Column:  valueNode.Column,
+			})
+			addBestEffortSkippedIndex(ctx, node, i)
+
+			continue
 		}
+
+		out.Set(reflect.Append(out, valueOut))
 	}
 
 	return nil
@@ -283,7 +323,18 @@ func unmarshalSequencedMap(ctx context.Context, node *yaml.Node, out reflect.Val
 		valueOut := reflect.New(sm.GetValueType()).Elem()
 
 		if err := unmarshal(ctx, valueNode, valueOut); err != nil {
-			return err
+			if !isBestEffort(ctx) {
+				return err
+			}
+
+			// Skip the entry rather than failing the whole map so the rest of the document can still be used
+			addBestEffortError(ctx, &validation.Error{
+				Message: fmt.Sprintf("failed to unmarshal key %s: %s", key, describeUnmarshalFailure(sm.GetValueType(), valueNode)),
+				Line:    valueNode.Line,
+				Column:  valueNode.Column,
+			})
+
+			continue
 		}
 
 		if err := sm.SetUntyped(key, valueOut.Interface()); err != nil {
@@ -301,3 +352,128 @@ func isUnmarshallable(out reflect.Value) bool {
 
 	return out.Type().Implements(reflect.TypeOf((*Unmarshallable)(nil)).Elem())
 }
+
+// describeUnmarshalFailure describes why a value node failed to unmarshal into a field in terms of the document's nodes rather than the Go types involved.
+func describeUnmarshalFailure(valueType reflect.Type, valueNode *yaml.Node) string {
+	expectedKind, ok := getExpectedNodeKind(valueType)
+	if !ok {
+		return fmt.Sprintf("invalid %s node", getNodeKindName(valueNode.Kind))
+	}
+
+	if expectedKind != valueNode.Kind {
+		return fmt.Sprintf("expected %s node, got %s node", getNodeKindName(expectedKind), getNodeKindName(valueNode.Kind))
+	}
+
+	if expectedKind == yaml.ScalarNode {
+		return fmt.Sprintf("expected %s value, got %s value", getScalarTypeName(valueType), getScalarTagName(valueNode.ShortTag()))
+	}
+
+	return fmt.Sprintf("invalid %s node", getNodeKindName(valueNode.Kind))
+}
+
+// getExpectedNodeKind returns the kind of node a value of the provided type is unmarshalled from, if it can be determined from the type alone.
+func getExpectedNodeKind(typ reflect.Type) (yaml.Kind, bool) {
+	if typ == nil || typ == reflect.TypeOf((*yaml.Node)(nil)) || typ == reflect.TypeOf(yaml.Node{}) {
+		return 0, false
+	}
+
+	ptrType := typ
+	if ptrType.Kind() != reflect.Ptr {
+		ptrType = reflect.PointerTo(typ)
+	}
+
+	// Custom unmarshallers can accept any kind of node, except for models with keyed fields which are always unmarshalled from a mapping
+	if ptrType.Implements(reflect.TypeOf((*Unmarshallable)(nil)).Elem()) {
+		if hasKeyedFields(ptrType.Elem()) {
+			return yaml.MappingNode, true
+		}
+		return 0, false
+	}
+	if ptrType.Implements(reflect.TypeOf((*SequencedMap)(nil)).Elem()) {
+		return yaml.MappingNode, true
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		return yaml.SequenceNode, true
+	case reflect.Struct, reflect.Map:
+		return yaml.MappingNode, true
+	case reflect.Interface:
+		return 0, false
+	default:
+		return yaml.ScalarNode, true
+	}
+}
+
+func hasKeyedFields(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).Tag.Get("key") != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getNodeKindName(kind yaml.Kind) string {
+	switch kind {
+	case yaml.DocumentNode:
+		return "document"
+	case yaml.SequenceNode:
+		return "sequence"
+	case yaml.MappingNode:
+		return "mapping"
+	case yaml.ScalarNode:
+		return "scalar"
+	case yaml.AliasNode:
+		return "alias"
+	default:
+		return "unknown"
+	}
+}
+
+func getScalarTypeName(typ reflect.Type) string {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+func getScalarTagName(tag string) string {
+	switch tag {
+	case "!!str":
+		return "string"
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	default:
+		return strings.TrimPrefix(tag, "!!")
+	}
+}
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -15,3 +16,24 @@ type Error struct {
 func (e Error) Error() string {
 	return fmt.Sprintf("[%d:%d] %s", e.Line, e.Column, e.Message)
 }
+
+// TruncatedError is returned as the last error when validation was stopped early because the limit set by WithMaxErrors was reached.
+type TruncatedError struct {
+	MaxErrors int
+}
+
+func (e TruncatedError) Error() string {
+	return fmt.Sprintf("validation stopped after %d errors", e.MaxErrors)
+}
+
+// IsTruncated returns true if the provided errors are the result of validation that was stopped early by WithMaxErrors.
+func IsTruncated(errs []error) bool {
+	for _, err := range errs {
+		var truncatedErr *TruncatedError
+		if errors.As(err, &truncatedErr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Formatter formats a list of errors (typically those returned from validating a document) for output.
+type Formatter interface {
+	Format(w io.Writer, errs []error) error
+}
+
+var (
+	_ Formatter = (*TextFormatter)(nil)
+	_ Formatter = (*JSONFormatter)(nil)
+	_ Formatter = (*JUnitFormatter)(nil)
+)
+
+// TextFormatter formats errors as plain text, one error per line.
+type TextFormatter struct{}
+
+func (f *TextFormatter) Format(w io.Writer, errs []error) error {
+	for _, err := range errs {
+		if _, err := fmt.Fprintln(w, err.Error()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// JSONFormatter formats errors as a JSON array of objects containing the line, column and message of each error.
+// Errors that aren't validation errors are output with a line and column of 0.
+type JSONFormatter struct {
+	// Indent is the string used to indent each level of the output, no indentation is used if empty.
+	Indent string
+}
+
+type jsonError struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+func (f *JSONFormatter) Format(w io.Writer, errs []error) error {
+	out := make([]jsonError, 0, len(errs))
+
+	for _, err := range errs {
+		vErr := toValidationError(err)
+
+		out = append(out, jsonError{
+			Line:    vErr.Line,
+			Column:  vErr.Column,
+			Message: vErr.Message,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", f.Indent)
+
+	return enc.Encode(out)
+}
+
+// JUnitFormatter formats errors as a JUnit XML report, with each error reported as a failing test case within a single test suite.
+// This allows CI systems that natively support JUnit reports to surface the errors as test failures.
+type JUnitFormatter struct {
+	// SuiteName is the name of the test suite in the report, defaults to "validation" if empty.
+	SuiteName string
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (f *JUnitFormatter) Format(w io.Writer, errs []error) error {
+	suiteName := f.SuiteName
+	if suiteName == "" {
+		suiteName = "validation"
+	}
+
+	suite := junitTestSuite{
+		Name:      suiteName,
+		Tests:     len(errs),
+		Failures:  len(errs),
+		TestCases: make([]junitTestCase, 0, len(errs)),
+	}
+
+	for _, err := range errs {
+		vErr := toValidationError(err)
+
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      fmt.Sprintf("%d:%d", vErr.Line, vErr.Column),
+			ClassName: suiteName,
+			Failure: &junitFailure{
+				Message: vErr.Message,
+				Type:    "error",
+				Text:    err.Error(),
+			},
+		})
+	}
+
+	report := junitTestSuites{
+		Tests:      suite.Tests,
+		Failures:   suite.Failures,
+		TestSuites: []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func toValidationError(err error) Error {
+	var vErrPtr *Error
+	if errors.As(err, &vErrPtr) {
+		return *vErrPtr
+	}
+
+	var vErr Error
+	if errors.As(err, &vErr) {
+		return vErr
+	}
+
+	return Error{
+		Message: err.Error(),
+	}
+}
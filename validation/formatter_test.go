@@ -0,0 +1,95 @@
+package validation_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/speakeasy-api/openapi/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testErrs = []error{
+	&validation.Error{Line: 1, Column: 9, Message: "Arazzo version must be 1.0.0"},
+	validation.Error{Line: 4, Column: 3, Message: "field version is missing"},
+	errors.New("some other error"),
+}
+
+func TestFormatter_Format_Success(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter validation.Formatter
+		errs      []error
+		want      string
+	}{
+		{
+			name:      "text",
+			formatter: &validation.TextFormatter{},
+			errs:      testErrs,
+			want: `[1:9] Arazzo version must be 1.0.0
+[4:3] field version is missing
+some other error
+`,
+		},
+		{
+			name:      "json",
+			formatter: &validation.JSONFormatter{Indent: "  "},
+			errs:      testErrs,
+			want: `[
+  {
+    "line": 1,
+    "column": 9,
+    "message": "Arazzo version must be 1.0.0"
+  },
+  {
+    "line": 4,
+    "column": 3,
+    "message": "field version is missing"
+  },
+  {
+    "line": 0,
+    "column": 0,
+    "message": "some other error"
+  }
+]
+`,
+		},
+		{
+			name:      "json with no errors",
+			formatter: &validation.JSONFormatter{},
+			errs:      nil,
+			want: `[]
+`,
+		},
+		{
+			name:      "junit",
+			formatter: &validation.JUnitFormatter{},
+			errs:      testErrs,
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" failures="3">
+  <testsuite name="validation" tests="3" failures="3">
+    <testcase name="1:9" classname="validation">
+      <failure message="Arazzo version must be 1.0.0" type="error">[1:9] Arazzo version must be 1.0.0</failure>
+    </testcase>
+    <testcase name="4:3" classname="validation">
+      <failure message="field version is missing" type="error">[4:3] field version is missing</failure>
+    </testcase>
+    <testcase name="0:0" classname="validation">
+      <failure message="some other error" type="error">some other error</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.NewBuffer([]byte{})
+
+			err := tt.formatter.Format(buf, tt.errs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
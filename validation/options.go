@@ -1,13 +1,16 @@
 package validation
 
 import (
+	"errors"
 	"reflect"
+	"slices"
 )
 
 type Option func(o *Options)
 
 type Options struct {
 	ContextObjects map[reflect.Type]any
+	MaxErrors      int
 }
 
 func WithContextObject[T any](obj *T) Option {
@@ -16,6 +19,14 @@ func WithContextObject[T any](obj *T) Option {
 	}
 }
 
+// WithMaxErrors will stop validation once n errors have been found, returning the first n errors followed by a *TruncatedError.
+// A value of 0 or less (the default) places no limit on the number of errors.
+func WithMaxErrors(n int) Option {
+	return func(o *Options) {
+		o.MaxErrors = n
+	}
+}
+
 func NewOptions(opts ...Option) *Options {
 	o := &Options{
 		ContextObjects: make(map[reflect.Type]any),
@@ -40,3 +51,35 @@ func GetContextObject[T any](o *Options) *T {
 
 	return obj.(*T)
 }
+
+// MaxErrorsExceeded returns true if the provided errors exceed the limit set by WithMaxErrors, in which case validation can stop early
+// as the result will be truncated.
+func (o *Options) MaxErrorsExceeded(errs []error) bool {
+	return o.MaxErrors > 0 && len(errs) > o.MaxErrors
+}
+
+// TruncateErrors limits the provided errors to the limit set by WithMaxErrors, appending a *TruncatedError if any errors were dropped.
+// Any *TruncatedError already in the errors (ie from a nested validation) is replaced by one for the overall result.
+func (o *Options) TruncateErrors(errs []error) []error {
+	if o.MaxErrors <= 0 {
+		return errs
+	}
+
+	truncated := IsTruncated(errs)
+
+	errs = slices.DeleteFunc(errs, func(err error) bool {
+		var truncatedErr *TruncatedError
+		return errors.As(err, &truncatedErr)
+	})
+
+	if len(errs) > o.MaxErrors {
+		errs = errs[:o.MaxErrors]
+		truncated = true
+	}
+
+	if truncated {
+		errs = append(errs, &TruncatedError{MaxErrors: o.MaxErrors})
+	}
+
+	return errs
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 
 	"gopkg.in/yaml.v3"
 )
@@ -23,11 +24,23 @@ const (
 	OutputFormatYAML OutputFormat = "yaml"
 )
 
+type LineEnding string
+
+const (
+	LineEndingLF   LineEnding = "\n"
+	LineEndingCRLF LineEnding = "\r\n"
+)
+
+// UTF8BOM is the byte order mark that may prefix UTF-8 encoded documents.
+var UTF8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 type Config struct {
 	KeyStringStyle   yaml.Style   // The default string style to use when creating new keys
 	ValueStringStyle yaml.Style   // The default string style to use when creating new nodes
 	Indentation      int          // The indentation level of the document
 	OutputFormat     OutputFormat // The output format to use when marshalling
+	LineEnding       LineEnding   // The line ending to use when marshalling
+	WriteBOM         bool         // Whether to prefix the output with a UTF-8 byte order mark when marshalling
 }
 
 var defaultConfig = &Config{
@@ -35,6 +48,8 @@ var defaultConfig = &Config{
 	KeyStringStyle:   0,
 	ValueStringStyle: 0,
 	OutputFormat:     OutputFormatYAML,
+	LineEnding:       LineEndingLF,
+	WriteBOM:         false,
 }
 
 func ContextWithConfig(ctx context.Context, config *Config) context.Context {
@@ -64,6 +79,15 @@ func GetConfigFromContext(ctx context.Context) *Config {
 func GetConfigFromDoc(data []byte, doc *yaml.Node) *Config {
 	cfg := *defaultConfig
 
+	if bytes.HasPrefix(data, UTF8BOM) {
+		cfg.WriteBOM = true
+		data = data[len(UTF8BOM):]
+	}
+
+	if bytes.Contains(data, []byte(LineEndingCRLF)) {
+		cfg.LineEnding = LineEndingCRLF
+	}
+
 	cfg.OutputFormat, cfg.Indentation = inspectData(data)
 
 	getGlobalStringStyle(doc, &cfg)
@@ -93,8 +117,9 @@ func inspectData(data []byte) (OutputFormat, int) {
 			docFormat = OutputFormatJSON
 			foundDocFormat = true
 		default:
-			if len(line) != len(trimLine) {
-				indentation = len(line) - len(trimLine)
+			leadingWhitespace := len(line) - len(bytes.TrimLeft(line, " \t"))
+			if leadingWhitespace > 0 {
+				indentation = leadingWhitespace
 				foundIndentation = true
 			}
 		}
@@ -107,6 +132,22 @@ func inspectData(data []byte) (OutputFormat, int) {
 	return docFormat, indentation
 }
 
+// Write will write the marshalled document data to the provided io.Writer, applying the line ending and byte order mark settings of the config.
+func (c *Config) Write(w io.Writer, data []byte) error {
+	if c.LineEnding == LineEndingCRLF {
+		data = bytes.ReplaceAll(data, []byte(LineEndingLF), []byte(LineEndingCRLF))
+	}
+
+	if c.WriteBOM {
+		if _, err := w.Write(UTF8BOM); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
 func getGlobalStringStyle(doc *yaml.Node, cfg *Config) {
 	foundMapKeyStyle := false
 	foundStringValueStyle := false